@@ -0,0 +1,24 @@
+package auth
+
+import "context"
+
+type contextKey string
+
+const (
+	userIDContextKey contextKey = "userID"
+	roleContextKey   contextKey = "role"
+)
+
+// UserIDFromContext returns the authenticated user's ID, as injected by
+// AuthMiddleware.
+func UserIDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(userIDContextKey).(int)
+	return id, ok
+}
+
+// RoleFromContext returns the authenticated user's role, as injected by
+// AuthMiddleware.
+func RoleFromContext(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(roleContextKey).(string)
+	return role, ok
+}
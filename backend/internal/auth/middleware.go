@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/escuadron-404/red404/backend/internal/middleware"
+	"github.com/escuadron-404/red404/backend/pkg/problem"
+)
+
+// Middleware validates the Authorization header and injects the caller's
+// identity into the request context for downstream handlers.
+func Middleware(authService AuthService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			instance := middleware.RequestIDFromContext(r.Context())
+
+			header := r.Header.Get("Authorization")
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || token == "" {
+				problem.Write(w, problem.New("auth.unauthorized", "Missing or malformed Authorization header", instance))
+				return
+			}
+
+			userID, role, err := authService.ParseAccessToken(token)
+			if err != nil {
+				problem.Write(w, problem.New("auth.invalid_token", "Invalid or expired token", instance))
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), userIDContextKey, userID)
+			ctx = context.WithValue(ctx, roleContextKey, role)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireSelfOrAdmin reports whether the authenticated caller is either the
+// resource owner (resourceUserID) or has the admin role.
+func RequireSelfOrAdmin(ctx context.Context, resourceUserID int) bool {
+	role, _ := RoleFromContext(ctx)
+	if role == "admin" {
+		return true
+	}
+	userID, ok := UserIDFromContext(ctx)
+	return ok && userID == resourceUserID
+}
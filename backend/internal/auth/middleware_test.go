@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequireSelfOrAdmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         int
+		role           string
+		resourceUserID int
+		want           bool
+	}{
+		{name: "owner may access own resource", userID: 1, role: "user", resourceUserID: 1, want: true},
+		{name: "non-owner may not access another user's resource", userID: 1, role: "user", resourceUserID: 2, want: false},
+		{name: "admin may access any resource", userID: 1, role: "admin", resourceUserID: 2, want: true},
+		{name: "missing identity is denied", userID: 0, role: "", resourceUserID: 1, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.role != "" || tt.userID != 0 {
+				ctx = context.WithValue(ctx, userIDContextKey, tt.userID)
+				ctx = context.WithValue(ctx, roleContextKey, tt.role)
+			}
+
+			if got := RequireSelfOrAdmin(ctx, tt.resourceUserID); got != tt.want {
+				t.Errorf("RequireSelfOrAdmin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/escuadron-404/red404/backend/internal/config"
+	"github.com/escuadron-404/red404/backend/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrInvalidToken       = errors.New("invalid or expired token")
+)
+
+// AuthService issues and validates the tokens that gate access to the API.
+type AuthService interface {
+	Login(ctx context.Context, email, password string) (accessToken, refreshToken string, err error)
+	Refresh(ctx context.Context, refreshToken string) (accessToken, newRefreshToken string, err error)
+	Logout(ctx context.Context, refreshToken string) error
+	ParseAccessToken(tokenString string) (userID int, role string, err error)
+}
+
+type authService struct {
+	cfg              config.AuthenticationConfig
+	userRepo         repository.UserRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+}
+
+func NewAuthService(cfg config.AuthenticationConfig, userRepo repository.UserRepository, refreshTokenRepo repository.RefreshTokenRepository) AuthService {
+	return &authService{
+		cfg:              cfg,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+	}
+}
+
+type accessClaims struct {
+	UserID int    `json:"userID"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+func (s *authService) Login(ctx context.Context, email, password string) (string, string, error) {
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil || user == nil {
+		return "", "", ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", "", ErrInvalidCredentials
+	}
+
+	accessToken, err := s.signAccessToken(user.ID, user.Role)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err := s.issueRefreshToken(ctx, user.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (string, string, error) {
+	hash := hashRefreshToken(refreshToken)
+
+	stored, err := s.refreshTokenRepo.GetByHash(ctx, hash)
+	if err != nil || stored.RevokedAt != nil || time.Now().After(stored.ExpiresAt) {
+		return "", "", ErrInvalidToken
+	}
+
+	user, err := s.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		return "", "", ErrInvalidToken
+	}
+
+	if err := s.refreshTokenRepo.Revoke(ctx, hash); err != nil {
+		return "", "", err
+	}
+
+	accessToken, err := s.signAccessToken(user.ID, user.Role)
+	if err != nil {
+		return "", "", err
+	}
+
+	newRefreshToken, err := s.issueRefreshToken(ctx, user.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, newRefreshToken, nil
+}
+
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	return s.refreshTokenRepo.Revoke(ctx, hashRefreshToken(refreshToken))
+}
+
+func (s *authService) ParseAccessToken(tokenString string) (int, string, error) {
+	claims := &accessClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return []byte(s.cfg.SecretKey), nil
+	})
+	if err != nil || !token.Valid {
+		return 0, "", ErrInvalidToken
+	}
+	return claims.UserID, claims.Role, nil
+}
+
+func (s *authService) signAccessToken(userID int, role string) (string, error) {
+	now := time.Now()
+	claims := accessClaims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.AccessTokenTTL)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.cfg.SecretKey))
+}
+
+func (s *authService) issueRefreshToken(ctx context.Context, userID int) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generating refresh token: %w", err)
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(raw)
+
+	err := s.refreshTokenRepo.Create(ctx, &repository.RefreshToken{
+		UserID:    userID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.cfg.RefreshTokenTTL),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
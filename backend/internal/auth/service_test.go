@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/escuadron-404/red404/backend/internal/config"
+	"github.com/escuadron-404/red404/backend/internal/models"
+	"github.com/escuadron-404/red404/backend/internal/pagination"
+	"github.com/escuadron-404/red404/backend/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type fakeUserRepo struct {
+	byID    map[int]*models.User
+	byEmail map[string]*models.User
+}
+
+func newFakeUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{byID: map[int]*models.User{}, byEmail: map[string]*models.User{}}
+}
+
+func (f *fakeUserRepo) Create(ctx context.Context, user *models.User) error {
+	user.ID = len(f.byID) + 1
+	f.byID[user.ID] = user
+	f.byEmail[user.Email] = user
+	return nil
+}
+
+func (f *fakeUserRepo) GetByID(ctx context.Context, id int) (*models.User, error) {
+	user, ok := f.byID[id]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (f *fakeUserRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	user, ok := f.byEmail[email]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (f *fakeUserRepo) List(ctx context.Context, limit, offset int) ([]*models.User, int, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeUserRepo) ListUsersKeyset(ctx context.Context, cursor pagination.Cursor, sortField, order string, limit int, filter repository.UserListFilter) ([]*models.User, int, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeUserRepo) Update(ctx context.Context, user *models.User) error {
+	f.byID[user.ID] = user
+	f.byEmail[user.Email] = user
+	return nil
+}
+
+func (f *fakeUserRepo) Delete(ctx context.Context, id int) error {
+	delete(f.byID, id)
+	return nil
+}
+
+type fakeRefreshTokenRepo struct {
+	byHash map[string]*repository.RefreshToken
+}
+
+func newFakeRefreshTokenRepo() *fakeRefreshTokenRepo {
+	return &fakeRefreshTokenRepo{byHash: map[string]*repository.RefreshToken{}}
+}
+
+func (f *fakeRefreshTokenRepo) Create(ctx context.Context, token *repository.RefreshToken) error {
+	f.byHash[token.TokenHash] = token
+	return nil
+}
+
+func (f *fakeRefreshTokenRepo) GetByHash(ctx context.Context, tokenHash string) (*repository.RefreshToken, error) {
+	token, ok := f.byHash[tokenHash]
+	if !ok {
+		return nil, repository.ErrRefreshTokenNotFound
+	}
+	return token, nil
+}
+
+func (f *fakeRefreshTokenRepo) Revoke(ctx context.Context, tokenHash string) error {
+	token, ok := f.byHash[tokenHash]
+	if !ok {
+		return repository.ErrRefreshTokenNotFound
+	}
+	now := time.Now()
+	token.RevokedAt = &now
+	return nil
+}
+
+func (f *fakeRefreshTokenRepo) RevokeAllForUser(ctx context.Context, userID int) error {
+	now := time.Now()
+	for _, token := range f.byHash {
+		if token.UserID == userID {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func newTestAuthService(t *testing.T) (AuthService, *fakeUserRepo) {
+	t.Helper()
+
+	userRepo := newFakeUserRepo()
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter22"), bcrypt.DefaultCost)
+	if err != nil {
+		t.Fatalf("hashing test password: %v", err)
+	}
+	user := &models.User{Email: "jane@example.com", Role: "user", PasswordHash: string(hash)}
+	if err := userRepo.Create(context.Background(), user); err != nil {
+		t.Fatalf("seeding test user: %v", err)
+	}
+
+	cfg := config.AuthenticationConfig{
+		SecretKey:       "test-secret",
+		SaltKey:         "test-salt",
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+	}
+
+	return NewAuthService(cfg, userRepo, newFakeRefreshTokenRepo()), userRepo
+}
+
+func TestAuthServiceLoginSuccess(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	accessToken, refreshToken, err := svc.Login(context.Background(), "jane@example.com", "hunter22")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if accessToken == "" || refreshToken == "" {
+		t.Fatalf("Login() returned empty tokens: access=%q refresh=%q", accessToken, refreshToken)
+	}
+
+	userID, role, err := svc.ParseAccessToken(accessToken)
+	if err != nil {
+		t.Fatalf("ParseAccessToken() error = %v", err)
+	}
+	if userID != 1 || role != "user" {
+		t.Errorf("ParseAccessToken() = (%d, %q), want (1, \"user\")", userID, role)
+	}
+}
+
+func TestAuthServiceLoginWrongPassword(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	if _, _, err := svc.Login(context.Background(), "jane@example.com", "wrong-password"); err != ErrInvalidCredentials {
+		t.Fatalf("Login() error = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthServiceLoginUnknownEmail(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	if _, _, err := svc.Login(context.Background(), "nobody@example.com", "hunter22"); err != ErrInvalidCredentials {
+		t.Fatalf("Login() error = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthServiceRefreshRotatesToken(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+	ctx := context.Background()
+
+	_, refreshToken, err := svc.Login(ctx, "jane@example.com", "hunter22")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	newAccessToken, newRefreshToken, err := svc.Refresh(ctx, refreshToken)
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if newAccessToken == "" || newRefreshToken == "" || newRefreshToken == refreshToken {
+		t.Fatalf("Refresh() did not rotate the refresh token: new=%q old=%q", newRefreshToken, refreshToken)
+	}
+
+	// The rotated-out token must no longer be usable.
+	if _, _, err := svc.Refresh(ctx, refreshToken); err != ErrInvalidToken {
+		t.Errorf("Refresh() with a revoked token error = %v, want ErrInvalidToken", err)
+	}
+
+	// The new token must still work.
+	if _, _, err := svc.Refresh(ctx, newRefreshToken); err != nil {
+		t.Errorf("Refresh() with the rotated token error = %v, want nil", err)
+	}
+}
+
+func TestAuthServiceRefreshUnknownToken(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	if _, _, err := svc.Refresh(context.Background(), "not-a-real-token"); err != ErrInvalidToken {
+		t.Fatalf("Refresh() error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestAuthServiceLogoutRevokesToken(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+	ctx := context.Background()
+
+	_, refreshToken, err := svc.Login(ctx, "jane@example.com", "hunter22")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	if err := svc.Logout(ctx, refreshToken); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+
+	if _, _, err := svc.Refresh(ctx, refreshToken); err != ErrInvalidToken {
+		t.Errorf("Refresh() after Logout() error = %v, want ErrInvalidToken", err)
+	}
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// AuthenticationConfig holds the secrets and TTLs of the auth subsystem.
+// SecretKey signs access tokens; SaltKey is loaded but not used by the auth
+// service. The keys are sourced from the environment; the TTLs are fixed.
+type AuthenticationConfig struct {
+	SecretKey       string
+	SaltKey         string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// LoadAuthenticationConfig reads the authentication configuration from the
+// environment, falling back to development-only defaults when unset.
+func LoadAuthenticationConfig() AuthenticationConfig {
+	return AuthenticationConfig{
+		SecretKey:       envOrDefault("AUTH_SECRET_KEY", "dev-secret-key"),
+		SaltKey:         envOrDefault("AUTH_SALT_KEY", "dev-salt-key"),
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,23 @@
+package dto
+
+// BulkCreateUsersRequest is the body of POST /users/bulk.
+type BulkCreateUsersRequest struct {
+	Users []CreateUserRequest `json:"users" validate:"required,min=1,max=500"`
+}
+
+// BulkDeleteUsersRequest is the body of DELETE /users/bulk.
+type BulkDeleteUsersRequest struct {
+	IDs []int `json:"ids" validate:"required,min=1,max=500"`
+}
+
+// BulkResult reports the outcome of a single item within a bulk operation.
+type BulkResult struct {
+	Index  int    `json:"index"`
+	ID     int    `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type BulkResponse struct {
+	Results []BulkResult `json:"results"`
+}
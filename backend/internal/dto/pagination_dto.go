@@ -0,0 +1,27 @@
+package dto
+
+// UserFilter narrows a user listing. Zero-value fields are left unapplied.
+type UserFilter struct {
+	Email        string
+	CreatedAfter string
+}
+
+// ListUsersParams carries the parsed query parameters GetAllUsers accepts
+// for the opaque cursor pagination path.
+type ListUsersParams struct {
+	Limit  int
+	Cursor string
+	Sort   string
+	Order  string
+	Filter UserFilter
+}
+
+// ListUsersResponse is returned by GetAllUsers's cursor-pagination path.
+// NextCursor/PrevCursor are empty when there is no further page in that
+// direction.
+type ListUsersResponse struct {
+	Data       []UserResponse `json:"data"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	PrevCursor string         `json:"prev_cursor,omitempty"`
+	TotalCount int            `json:"total_count"`
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import "github.com/escuadron-404/red404/backend/internal/openapi"
+
+func init() {
+	openapi.Register("CreateUser", openapi.Op{
+		Summary: "Create a new user",
+		Tags:    []string{"users"},
+	})
+	openapi.Register("GetUserByID", openapi.Op{
+		Summary: "Get a user by ID",
+		Tags:    []string{"users"},
+	})
+	openapi.Register("GetAllUsers", openapi.Op{
+		Summary: "List users, with offset or cursor pagination",
+		Tags:    []string{"users"},
+	})
+	openapi.Register("UpdateUser", openapi.Op{
+		Summary: "Update a user",
+		Tags:    []string{"users"},
+	})
+	openapi.Register("DeleteUser", openapi.Op{
+		Summary: "Delete a user",
+		Tags:    []string{"users"},
+	})
+}
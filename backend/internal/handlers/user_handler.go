@@ -2,45 +2,134 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/escuadron-404/red404/backend/internal/auth"
 	"github.com/escuadron-404/red404/backend/internal/dto"
+	"github.com/escuadron-404/red404/backend/internal/middleware"
+	"github.com/escuadron-404/red404/backend/internal/repository"
 	"github.com/escuadron-404/red404/backend/internal/services"
 	"github.com/escuadron-404/red404/backend/pkg/common"
+	"github.com/escuadron-404/red404/backend/pkg/problem"
 	"github.com/go-playground/validator/v10"
 )
 
 type UserHandler struct {
 	userService services.UserService
+	authService auth.AuthService
 	validator   *validator.Validate
 }
 
-func NewUserHandler(userService services.UserService, userValidator *validator.Validate) *UserHandler {
+func NewUserHandler(userService services.UserService, authService auth.AuthService, userValidator *validator.Validate) *UserHandler {
 	return &UserHandler{
 		userService: userService,
+		authService: authService,
 		validator:   userValidator,
 	}
 }
 
+// Login exchanges an email/password pair for a short-lived access token and
+// a rotating refresh token.
+func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
+	var req dto.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.handleValidationErrors(w, err, instance)
+		return
+	}
+
+	accessToken, refreshToken, err := h.authService.Login(r.Context(), req.Email, req.Password)
+	if err != nil {
+		problem.Write(w, problem.New("auth.invalid_credentials", "Invalid email or password", instance))
+		return
+	}
+
+	common.SuccessResponse(w, dto.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, "Login successful")
+}
+
+// Refresh rotates a refresh token, returning a new access/refresh pair and
+// revoking the one that was presented.
+func (h *UserHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
+	var req dto.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.handleValidationErrors(w, err, instance)
+		return
+	}
+
+	accessToken, refreshToken, err := h.authService.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		problem.Write(w, problem.New("auth.invalid_token", "Invalid or expired refresh token", instance))
+		return
+	}
+
+	common.SuccessResponse(w, dto.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, "Token refreshed")
+}
+
+// Logout revokes the presented refresh token so it can no longer be used to
+// mint new access tokens.
+func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
+	var req dto.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
+		return
+	}
+
+	if err := h.authService.Logout(r.Context(), req.RefreshToken); err != nil {
+		problem.Write(w, problem.New("auth.invalid_token", "Failed to logout", instance))
+		return
+	}
+
+	common.SuccessResponse(w, nil, "Logout successful")
+}
+
 func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
 	var req dto.CreateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, "Invalid JSON", nil)
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
 		return
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		h.handleValidationErrors(w, err)
+		h.handleValidationErrors(w, err, instance)
 		return
 	}
 
 	user, err := h.userService.CreateUser(r.Context(), req)
 	if err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		if errors.Is(err, services.ErrEmailTaken) {
+			problem.Write(w, problem.New("users.email_taken", err.Error(), instance))
+			return
+		}
+		problem.Write(w, problem.New("users.validation_failed", err.Error(), instance))
 		return
 	}
 
@@ -48,15 +137,22 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, "Invalid user ID", nil)
+		problem.Write(w, problem.New("users.invalid_id", "Invalid user ID", instance))
+		return
+	}
+
+	if !auth.RequireSelfOrAdmin(r.Context(), id) {
+		problem.Write(w, problem.New("users.forbidden", "You may only access your own account", instance))
 		return
 	}
 
 	user, err := h.userService.GetUserByID(r.Context(), id)
 	if err != nil {
-		common.ErrorResponse(w, http.StatusNotFound, "User not found", nil)
+		problem.Write(w, problem.New("users.not_found", "User not found", instance))
 		return
 	}
 
@@ -65,9 +161,42 @@ func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 
 func (h *UserHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	query := r.URL.Query()
+	instance := middleware.RequestIDFromContext(ctx)
+
+	cursor := query.Get("cursor")
+	filterEmail := query.Get("filter[email]")
+	filterCreatedAfter := query.Get("filter[created_after]")
+
+	// Cursor pagination takes over as soon as a cursor, sort, or filter is
+	// present; otherwise the legacy limit/offset listing is preserved for
+	// existing callers.
+	if cursor != "" || query.Get("sort") != "" || filterEmail != "" || filterCreatedAfter != "" {
+		limit, _ := strconv.Atoi(query.Get("limit"))
 
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
+		params := dto.ListUsersParams{
+			Limit:  limit,
+			Cursor: cursor,
+			Sort:   query.Get("sort"),
+			Order:  query.Get("order"),
+			Filter: dto.UserFilter{
+				Email:        filterEmail,
+				CreatedAfter: filterCreatedAfter,
+			},
+		}
+
+		result, err := h.userService.ListUsers(ctx, params)
+		if err != nil {
+			problem.Write(w, problem.New("users.validation_failed", err.Error(), instance))
+			return
+		}
+
+		common.SuccessResponse(w, result, "Users retrieved successfully")
+		return
+	}
+
+	limitStr := query.Get("limit")
+	offsetStr := query.Get("offset")
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil || limit <= 0 {
@@ -81,7 +210,7 @@ func (h *UserHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 
 	users, totalCount, err := h.userService.GetAllUsers(ctx, limit, offset)
 	if err != nil {
-		http.Error(w, "Failed to retrieve users", http.StatusInternalServerError)
+		problem.Write(w, problem.New("common.internal_error", "Failed to retrieve users", instance))
 		return
 	}
 
@@ -104,27 +233,38 @@ func (h *UserHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, "Invalid user ID", nil)
+		problem.Write(w, problem.New("users.invalid_id", "Invalid user ID", instance))
+		return
+	}
+
+	if !auth.RequireSelfOrAdmin(r.Context(), id) {
+		problem.Write(w, problem.New("users.forbidden", "You may only modify your own account", instance))
 		return
 	}
 
 	var req dto.UpdateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, "Invalid JSON", nil)
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
 		return
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		h.handleValidationErrors(w, err)
+		h.handleValidationErrors(w, err, instance)
 		return
 	}
 
 	user, err := h.userService.UpdateUser(r.Context(), id, req)
 	if err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			problem.Write(w, problem.New("users.not_found", err.Error(), instance))
+			return
+		}
+		problem.Write(w, problem.New("users.validation_failed", err.Error(), instance))
 		return
 	}
 
@@ -132,23 +272,39 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
 	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
-		common.ErrorResponse(w, http.StatusBadRequest, "Invalid user ID", nil)
+		problem.Write(w, problem.New("users.invalid_id", "Invalid user ID", instance))
+		return
+	}
+
+	if !auth.RequireSelfOrAdmin(r.Context(), id) {
+		problem.Write(w, problem.New("users.forbidden", "You may only delete your own account", instance))
 		return
 	}
 
 	err = h.userService.DeleteUser(r.Context(), id)
 	if err != nil {
-		common.ErrorResponse(w, http.StatusNotFound, err.Error(), nil)
+		problem.Write(w, problem.New("users.not_found", err.Error(), instance))
 		return
 	}
 
 	common.SuccessResponse(w, nil, "User deleted successfully")
 }
 
-func (h *UserHandler) handleValidationErrors(w http.ResponseWriter, err error) {
-	var validationErrors = make([]dto.ValidationError, len(err.(validator.ValidationErrors)))
+func (h *UserHandler) handleValidationErrors(w http.ResponseWriter, err error, instance string) {
+	detail := problem.New("users.validation_failed", "One or more fields failed validation", instance).
+		WithFieldErrors(fieldErrors(err))
+	problem.Write(w, detail)
+}
+
+// fieldErrors turns a validator.ValidationErrors into the project's
+// FieldError shape, used both for single-resource requests and to report
+// per-item failures in bulk operations.
+func fieldErrors(err error) []problem.FieldError {
+	var fieldErrs []problem.FieldError
 
 	for _, err := range err.(validator.ValidationErrors) {
 		var message string
@@ -162,21 +318,94 @@ func (h *UserHandler) handleValidationErrors(w http.ResponseWriter, err error) {
 		default:
 			message = fmt.Sprintf("%s is invalid", err.Field())
 		}
-		validationErrors = append(validationErrors, dto.ValidationError{
+		fieldErrs = append(fieldErrs, problem.FieldError{
 			Field:   err.Field(),
 			Message: message,
 		})
 	}
 
-	response := dto.ErrorResponse{
-		Success: false,
-		Message: "Validation failed",
-		Errors:  validationErrors,
+	return fieldErrs
+}
+
+// fieldErrorSummary collapses field errors into a single human-readable
+// string for bulk result reports, which carry one error message per item
+// rather than a structured list.
+func fieldErrorSummary(err error) string {
+	var messages []string
+	for _, fe := range fieldErrors(err) {
+		messages = append(messages, fe.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// BulkCreateUsers handles POST /users/bulk. Pass ?atomic=true to roll the
+// entire batch back on the first item failure; the default is best-effort,
+// where each item commits or fails independently.
+func (h *UserHandler) BulkCreateUsers(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
+	var req dto.BulkCreateUsersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
+		return
+	}
+	if len(req.Users) == 0 || len(req.Users) > services.MaxBulkBatchSize {
+		problem.Write(w, problem.New("users.validation_failed", fmt.Sprintf("users must contain between 1 and %d items", services.MaxBulkBatchSize), instance))
+		return
+	}
+
+	results := make([]dto.BulkResult, len(req.Users))
+	validItems := make([]services.BulkCreateItem, 0, len(req.Users))
+	for i, item := range req.Users {
+		if err := h.validator.Struct(item); err != nil {
+			results[i] = dto.BulkResult{Index: i, Status: "failed", Error: fieldErrorSummary(err)}
+			continue
+		}
+		validItems = append(validItems, services.BulkCreateItem{Index: i, Request: item})
+	}
+
+	atomic := r.URL.Query().Get("atomic") == "true"
+	if atomic && len(validItems) < len(req.Users) {
+		for _, item := range validItems {
+			results[item.Index] = dto.BulkResult{Index: item.Index, Status: "rolled_back"}
+		}
+		common.SuccessResponse(w, dto.BulkResponse{Results: results}, "Bulk create processed")
+		return
+	}
+
+	created, err := h.userService.BulkCreateUsers(r.Context(), validItems, atomic)
+	if err != nil {
+		problem.Write(w, problem.New("users.validation_failed", err.Error(), instance))
+		return
+	}
+	for _, res := range created {
+		results[res.Index] = res
+	}
+
+	common.SuccessResponse(w, dto.BulkResponse{Results: results}, "Bulk create processed")
+}
+
+// BulkDeleteUsers handles DELETE /users/bulk, with the same ?atomic= mode as
+// BulkCreateUsers.
+func (h *UserHandler) BulkDeleteUsers(w http.ResponseWriter, r *http.Request) {
+	instance := middleware.RequestIDFromContext(r.Context())
+
+	var req dto.BulkDeleteUsersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Write(w, problem.New("users.validation_failed", "Invalid JSON", instance))
+		return
+	}
+	if len(req.IDs) == 0 || len(req.IDs) > services.MaxBulkBatchSize {
+		problem.Write(w, problem.New("users.validation_failed", fmt.Sprintf("ids must contain between 1 and %d items", services.MaxBulkBatchSize), instance))
+		return
+	}
+
+	atomic := r.URL.Query().Get("atomic") == "true"
+	results, err := h.userService.BulkDeleteUsers(r.Context(), req.IDs, atomic)
+	if err != nil {
+		problem.Write(w, problem.New("users.validation_failed", err.Error(), instance))
+		return
 	}
 
-	common.JSONResponse(w, http.StatusBadRequest, common.Response{
-		Success: false,
-		Message: "Validation failed",
-		Error:   response,
-	})
+	common.SuccessResponse(w, dto.BulkResponse{Results: results}, "Bulk delete processed")
 }
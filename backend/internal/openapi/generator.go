@@ -0,0 +1,141 @@
+package openapi
+
+import (
+	"strings"
+
+	"github.com/escuadron-404/red404/backend/pkg/problem"
+)
+
+// Document is a minimal OpenAPI 3.1 document - just enough of the spec to
+// describe this API's routes, parameters, bodies, and error shape.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type PathItem map[string]Operation
+
+type Operation struct {
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string              `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Generate builds the OpenAPI document from Routes and whatever metadata
+// was registered via Register.
+func Generate() *Document {
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info: Info{
+			Title:   "red404 API",
+			Version: "1.0.0",
+		},
+		Paths: map[string]PathItem{},
+		Components: Components{
+			Schemas: map[string]*Schema{
+				"Problem": SchemaFromStruct(problem.Detail{}),
+			},
+		},
+	}
+
+	for _, route := range Routes {
+		op := Operation{
+			Responses: map[string]Response{
+				"default": {
+					Description: "Unexpected error",
+					Content: map[string]MediaType{
+						problem.ContentType: {Schema: &Schema{Type: "object"}},
+					},
+				},
+			},
+		}
+
+		if meta, ok := operations[route.HandlerName]; ok {
+			op.Summary = meta.Summary
+			op.Tags = meta.Tags
+		}
+
+		for _, name := range route.PathParams {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"},
+			})
+		}
+		for _, name := range route.QueryParams {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name: name, In: "query", Required: false, Schema: &Schema{Type: "string"},
+			})
+		}
+
+		if route.RequestBody != nil {
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]MediaType{
+					"application/json": {Schema: schemaRef(doc, route.HandlerName+"Request", route.RequestBody)},
+				},
+			}
+		}
+
+		successStatus := successStatusFor(route.Method)
+		resp := Response{Description: "Successful response"}
+		if route.ResponseBody != nil {
+			resp.Content = map[string]MediaType{
+				"application/json": {Schema: schemaRef(doc, route.HandlerName+"Response", route.ResponseBody)},
+			}
+		}
+		op.Responses[successStatus] = resp
+
+		path := strings.ToLower(route.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = PathItem{}
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = op
+	}
+
+	return doc
+}
+
+func schemaRef(doc *Document, name string, v interface{}) *Schema {
+	doc.Components.Schemas[name] = SchemaFromStruct(v)
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func successStatusFor(method string) string {
+	if method == "POST" {
+		return "201"
+	}
+	return "200"
+}
@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ServeSpec handles GET /openapi.json, serving the generated document fresh
+// on every request so it never drifts from the handlers it describes.
+func ServeSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(Generate()); err != nil {
+		http.Error(w, "Failed to encode OpenAPI document", http.StatusInternalServerError)
+	}
+}
+
+// ServeDocs handles GET /docs, rendering Swagger UI (loaded from its CDN
+// bundle) against /openapi.json.
+func ServeDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(swaggerUIPage))
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>red404 API docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => {
+      SwaggerUIBundle({
+        url: "/openapi.json",
+        dom_id: "#swagger-ui",
+      });
+    };
+  </script>
+</body>
+</html>
+`
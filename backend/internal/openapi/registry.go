@@ -0,0 +1,20 @@
+// Package openapi builds an OpenAPI 3.1 document from the registered routes
+// and dto structs, so the API's shape is described by reflection over real
+// Go types rather than hand-maintained YAML.
+package openapi
+
+// Op carries the human-facing metadata for one handler that can't be
+// inferred from its signature or the dto it reads.
+type Op struct {
+	Summary string
+	Tags    []string
+}
+
+var operations = map[string]Op{}
+
+// Register attaches descriptive metadata to a handler by name. Call this
+// once per handler, typically from an init() in the package that defines
+// it, so the handler itself stays free of documentation comments.
+func Register(handlerName string, op Op) {
+	operations[handlerName] = op
+}
@@ -0,0 +1,60 @@
+package openapi
+
+import (
+	"github.com/escuadron-404/red404/backend/internal/dto"
+)
+
+// RouteSpec describes one registered mux route well enough to generate a
+// path item: what it reads from the path/query and what it decodes as a
+// body. It mirrors net/http.ServeMux registration, not a separate router
+// abstraction, so there's exactly one place routes are declared.
+type RouteSpec struct {
+	Method       string
+	Path         string
+	HandlerName  string
+	PathParams   []string
+	QueryParams  []string
+	RequestBody  interface{}
+	ResponseBody interface{}
+}
+
+// Routes lists the UserHandler routes documented by this generator. Keep it
+// in sync with how the handlers actually read r.PathValue/r.URL.Query/
+// json.Decode - this table is the single source of truth for the spec.
+var Routes = []RouteSpec{
+	{
+		Method:       "POST",
+		Path:         "/users",
+		HandlerName:  "CreateUser",
+		RequestBody:  dto.CreateUserRequest{},
+		ResponseBody: dto.UserResponse{},
+	},
+	{
+		Method:       "GET",
+		Path:         "/users/{id}",
+		HandlerName:  "GetUserByID",
+		PathParams:   []string{"id"},
+		ResponseBody: dto.UserResponse{},
+	},
+	{
+		Method:       "GET",
+		Path:         "/users",
+		HandlerName:  "GetAllUsers",
+		QueryParams:  []string{"limit", "offset", "cursor", "sort", "order", "filter[email]", "filter[created_after]"},
+		ResponseBody: dto.ListUsersResponse{},
+	},
+	{
+		Method:       "PUT",
+		Path:         "/users/{id}",
+		HandlerName:  "UpdateUser",
+		PathParams:   []string{"id"},
+		RequestBody:  dto.UpdateUserRequest{},
+		ResponseBody: dto.UserResponse{},
+	},
+	{
+		Method:      "DELETE",
+		Path:        "/users/{id}",
+		HandlerName: "DeleteUser",
+		PathParams:  []string{"id"},
+	},
+}
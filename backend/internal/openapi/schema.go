@@ -0,0 +1,112 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Schema is a (deliberately partial) JSON Schema, covering the subset
+// OpenAPI 3.1 request/response bodies in this API actually need.
+type Schema struct {
+	Ref        string             `json:"$ref,omitempty"`
+	Type       string             `json:"type,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	MinLength  *int               `json:"minLength,omitempty"`
+}
+
+// SchemaFromStruct reflects over a struct (or slice of structs) and its
+// `validate` tags to build a JSON Schema, so request/response documentation
+// stays in sync with the actual dto types without hand-written duplicates.
+func SchemaFromStruct(v interface{}) *Schema {
+	return schemaForType(reflect.TypeOf(v))
+}
+
+func schemaForType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: schemaForType(t.Elem())}
+	case reflect.Struct:
+		return schemaForStruct(t)
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	default:
+		return &Schema{Type: "object"}
+	}
+}
+
+func schemaForStruct(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := jsonFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		prop := schemaForType(field.Type)
+		applyValidateTag(prop, field.Tag.Get("validate"))
+		s.Properties[name] = prop
+
+		if hasValidateRule(field.Tag.Get("validate"), "required") {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	return s
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func applyValidateTag(prop *Schema, tag string) {
+	if tag == "" {
+		return
+	}
+
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "email":
+			prop.Format = "email"
+		case "min":
+			if prop.Type == "string" {
+				if n, err := strconv.Atoi(param); err == nil {
+					prop.MinLength = &n
+				}
+			}
+		}
+	}
+}
+
+func hasValidateRule(tag, rule string) bool {
+	for _, r := range strings.Split(tag, ",") {
+		name, _, _ := strings.Cut(r, "=")
+		if name == rule {
+			return true
+		}
+	}
+	return false
+}
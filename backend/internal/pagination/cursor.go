@@ -0,0 +1,88 @@
+// Package pagination implements opaque keyset cursors for list endpoints
+// that need to scale past deep offset scans.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	DirectionAsc  = "asc"
+	DirectionDesc = "desc"
+
+	MaxLimit     = 100
+	DefaultLimit = 10
+)
+
+// SortableFields is the allowlist of columns that may be used as the
+// keyset's sort column; accepting arbitrary input here would let a caller
+// sort by an unindexed column and defeat the purpose of keyset pagination.
+var SortableFields = map[string]bool{
+	"created_at": true,
+	"id":         true,
+}
+
+// Cursor identifies the last row a page ended on so the next page can
+// resume with a keyset WHERE (sort_col, id) > (?, ?) query.
+type Cursor struct {
+	LastSortValue string `json:"last_sort_value"`
+	LastID        int    `json:"last_id"`
+	Direction     string `json:"direction"`
+}
+
+func Encode(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(raw)
+}
+
+func Decode(encoded string) (Cursor, error) {
+	var c Cursor
+	if encoded == "" {
+		return c, nil
+	}
+	raw, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return c, nil
+}
+
+// ValidateSort checks a requested sort field against the allowlist,
+// defaulting to "created_at" when the field is empty.
+func ValidateSort(field string) (string, error) {
+	if field == "" {
+		return "created_at", nil
+	}
+	if !SortableFields[field] {
+		return "", fmt.Errorf("invalid sort field: %s", field)
+	}
+	return field, nil
+}
+
+// ValidateOrder checks a requested sort direction, defaulting to "desc".
+func ValidateOrder(order string) (string, error) {
+	switch order {
+	case "":
+		return DirectionDesc, nil
+	case DirectionAsc, DirectionDesc:
+		return order, nil
+	default:
+		return "", fmt.Errorf("invalid order: %s", order)
+	}
+}
+
+// ClampLimit enforces the configured maximum page size.
+func ClampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
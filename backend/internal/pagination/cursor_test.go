@@ -0,0 +1,104 @@
+package pagination
+
+import "testing"
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	c := Cursor{LastSortValue: "2024-01-02T15:04:05Z", LastID: 42, Direction: DirectionAsc}
+
+	decoded, err := Decode(Encode(c))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if decoded != c {
+		t.Errorf("Decode(Encode(c)) = %+v, want %+v", decoded, c)
+	}
+}
+
+func TestDecodeEmpty(t *testing.T) {
+	c, err := Decode("")
+	if err != nil {
+		t.Fatalf("Decode(\"\") error = %v", err)
+	}
+	if c != (Cursor{}) {
+		t.Errorf("Decode(\"\") = %+v, want zero value", c)
+	}
+}
+
+func TestDecodeInvalidBase64(t *testing.T) {
+	if _, err := Decode("not-valid-base64!!"); err == nil {
+		t.Error("Decode() with invalid base64 error = nil, want non-nil")
+	}
+}
+
+func TestValidateSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty defaults to created_at", field: "", want: "created_at"},
+		{name: "id is sortable", field: "id", want: "id"},
+		{name: "created_at is sortable", field: "created_at", want: "created_at"},
+		{name: "unknown field is rejected", field: "password_hash", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateSort(tt.field)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSort(%q) error = %v, wantErr %v", tt.field, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ValidateSort(%q) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty defaults to desc", order: "", want: DirectionDesc},
+		{name: "asc is valid", order: DirectionAsc, want: DirectionAsc},
+		{name: "desc is valid", order: DirectionDesc, want: DirectionDesc},
+		{name: "unknown order is rejected", order: "sideways", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateOrder(tt.order)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateOrder(%q) error = %v, wantErr %v", tt.order, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ValidateOrder(%q) = %q, want %q", tt.order, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClampLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "zero defaults", limit: 0, want: DefaultLimit},
+		{name: "negative defaults", limit: -5, want: DefaultLimit},
+		{name: "in range passes through", limit: 25, want: 25},
+		{name: "over max clamps", limit: MaxLimit + 50, want: MaxLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClampLimit(tt.limit); got != tt.want {
+				t.Errorf("ClampLimit(%d) = %d, want %d", tt.limit, got, tt.want)
+			}
+		})
+	}
+}
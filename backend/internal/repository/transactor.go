@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// Transactor runs fn inside a single database transaction, committing when
+// fn returns nil and rolling back otherwise. Implementations thread the
+// transaction through the returned context so repository calls inside fn
+// participate in it.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
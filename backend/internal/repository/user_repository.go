@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/escuadron-404/red404/backend/internal/models"
+	"github.com/escuadron-404/red404/backend/internal/pagination"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+// UserRepository abstracts persistence for users.
+type UserRepository interface {
+	Create(ctx context.Context, user *models.User) error
+	GetByID(ctx context.Context, id int) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	List(ctx context.Context, limit, offset int) ([]*models.User, int, error)
+	// ListUsersKeyset returns up to limit+1 users ordered by (sortField, id)
+	// starting after cursor, so the caller can detect whether a next page
+	// exists without a separate COUNT query.
+	ListUsersKeyset(ctx context.Context, cursor pagination.Cursor, sortField, order string, limit int, filter UserListFilter) ([]*models.User, int, error)
+	Update(ctx context.Context, user *models.User) error
+	Delete(ctx context.Context, id int) error
+}
+
+// UserListFilter narrows a keyset listing by the caller-supplied filter[...]
+// query parameters.
+type UserListFilter struct {
+	Email        string
+	CreatedAfter string
+}
@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/escuadron-404/red404/backend/internal/dto"
+	"github.com/escuadron-404/red404/backend/internal/models"
+	"github.com/escuadron-404/red404/backend/internal/pagination"
+	"github.com/escuadron-404/red404/backend/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrEmailTaken = errors.New("email already in use")
+
+// MaxBulkBatchSize caps how many items a single bulk request may contain.
+const MaxBulkBatchSize = 500
+
+// BulkCreateItem pairs a create request with its position in the original
+// request body, so results can be reported back in that same order.
+type BulkCreateItem struct {
+	Index   int
+	Request dto.CreateUserRequest
+}
+
+// UserService contains the business rules for managing user accounts.
+type UserService interface {
+	CreateUser(ctx context.Context, req dto.CreateUserRequest) (dto.UserResponse, error)
+	GetUserByID(ctx context.Context, id int) (dto.UserResponse, error)
+	GetAllUsers(ctx context.Context, limit, offset int) ([]dto.UserResponse, int, error)
+	// ListUsers supports opaque cursor pagination; when params.Cursor is
+	// empty it lists from the beginning in the requested sort order.
+	ListUsers(ctx context.Context, params dto.ListUsersParams) (dto.ListUsersResponse, error)
+	UpdateUser(ctx context.Context, id int, req dto.UpdateUserRequest) (dto.UserResponse, error)
+	DeleteUser(ctx context.Context, id int) error
+	// BulkCreateUsers creates items in the given order. In atomic mode the
+	// first failure rolls back every item in the batch; otherwise each item
+	// commits or fails independently.
+	BulkCreateUsers(ctx context.Context, items []BulkCreateItem, atomic bool) ([]dto.BulkResult, error)
+	// BulkDeleteUsers deletes the given IDs in order, with the same atomic
+	// semantics as BulkCreateUsers.
+	BulkDeleteUsers(ctx context.Context, ids []int, atomic bool) ([]dto.BulkResult, error)
+}
+
+type userService struct {
+	userRepo  repository.UserRepository
+	txManager repository.Transactor
+}
+
+func NewUserService(userRepo repository.UserRepository, txManager repository.Transactor) UserService {
+	return &userService{userRepo: userRepo, txManager: txManager}
+}
+
+func (s *userService) CreateUser(ctx context.Context, req dto.CreateUserRequest) (dto.UserResponse, error) {
+	if existing, err := s.userRepo.GetByEmail(ctx, req.Email); err == nil && existing != nil {
+		return dto.UserResponse{}, ErrEmailTaken
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return dto.UserResponse{}, fmt.Errorf("hashing password: %w", err)
+	}
+
+	user := &models.User{
+		Email:        req.Email,
+		Name:         req.Name,
+		PasswordHash: string(hash),
+		Role:         "user",
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return dto.UserResponse{}, err
+	}
+
+	return toUserResponse(user), nil
+}
+
+func (s *userService) GetUserByID(ctx context.Context, id int) (dto.UserResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return dto.UserResponse{}, err
+	}
+	return toUserResponse(user), nil
+}
+
+func (s *userService) GetAllUsers(ctx context.Context, limit, offset int) ([]dto.UserResponse, int, error) {
+	users, total, err := s.userRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]dto.UserResponse, 0, len(users))
+	for _, u := range users {
+		responses = append(responses, toUserResponse(u))
+	}
+	return responses, total, nil
+}
+
+func (s *userService) ListUsers(ctx context.Context, params dto.ListUsersParams) (dto.ListUsersResponse, error) {
+	sortField, err := pagination.ValidateSort(params.Sort)
+	if err != nil {
+		return dto.ListUsersResponse{}, err
+	}
+	order, err := pagination.ValidateOrder(params.Order)
+	if err != nil {
+		return dto.ListUsersResponse{}, err
+	}
+	limit := pagination.ClampLimit(params.Limit)
+
+	cursor, err := pagination.Decode(params.Cursor)
+	if err != nil {
+		return dto.ListUsersResponse{}, err
+	}
+	if cursor.Direction == "" {
+		cursor.Direction = order
+	}
+
+	users, total, err := s.userRepo.ListUsersKeyset(ctx, cursor, sortField, order, limit, repository.UserListFilter{
+		Email:        params.Filter.Email,
+		CreatedAfter: params.Filter.CreatedAfter,
+	})
+	if err != nil {
+		return dto.ListUsersResponse{}, err
+	}
+
+	hasNext := len(users) > limit
+	if hasNext {
+		users = users[:limit]
+	}
+
+	responses := make([]dto.UserResponse, 0, len(users))
+	for _, u := range users {
+		responses = append(responses, toUserResponse(u))
+	}
+
+	resp := dto.ListUsersResponse{
+		Data:       responses,
+		TotalCount: total,
+	}
+	if hasNext && len(users) > 0 {
+		last := users[len(users)-1]
+		resp.NextCursor = pagination.Encode(pagination.Cursor{
+			LastSortValue: sortValue(last, sortField),
+			LastID:        last.ID,
+			Direction:     order,
+		})
+	}
+	if params.Cursor != "" && len(users) > 0 {
+		first := users[0]
+		resp.PrevCursor = pagination.Encode(pagination.Cursor{
+			LastSortValue: sortValue(first, sortField),
+			LastID:        first.ID,
+			Direction:     reverse(order),
+		})
+	}
+
+	return resp, nil
+}
+
+func sortValue(u *models.User, sortField string) string {
+	if sortField == "id" {
+		return fmt.Sprintf("%d", u.ID)
+	}
+	return u.CreatedAt.Format(time.RFC3339Nano)
+}
+
+func reverse(order string) string {
+	if order == pagination.DirectionAsc {
+		return pagination.DirectionDesc
+	}
+	return pagination.DirectionAsc
+}
+
+func (s *userService) UpdateUser(ctx context.Context, id int, req dto.UpdateUserRequest) (dto.UserResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return dto.UserResponse{}, err
+	}
+
+	if req.Email != "" {
+		user.Email = req.Email
+	}
+	if req.Name != "" {
+		user.Name = req.Name
+	}
+	if req.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return dto.UserResponse{}, fmt.Errorf("hashing password: %w", err)
+		}
+		user.PasswordHash = string(hash)
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return dto.UserResponse{}, err
+	}
+	return toUserResponse(user), nil
+}
+
+func (s *userService) DeleteUser(ctx context.Context, id int) error {
+	return s.userRepo.Delete(ctx, id)
+}
+
+func (s *userService) BulkCreateUsers(ctx context.Context, items []BulkCreateItem, atomic bool) ([]dto.BulkResult, error) {
+	results := make([]dto.BulkResult, len(items))
+
+	if !atomic {
+		for i, item := range items {
+			user, err := s.CreateUser(ctx, item.Request)
+			if err != nil {
+				results[i] = dto.BulkResult{Index: item.Index, Status: "failed", Error: err.Error()}
+				continue
+			}
+			results[i] = dto.BulkResult{Index: item.Index, ID: user.ID, Status: "created"}
+		}
+		return results, nil
+	}
+
+	err := s.txManager.WithinTx(ctx, func(txCtx context.Context) error {
+		var firstErr error
+		for i, item := range items {
+			user, err := s.CreateUser(txCtx, item.Request)
+			if err != nil {
+				results[i] = dto.BulkResult{Index: item.Index, Status: "failed", Error: err.Error()}
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			results[i] = dto.BulkResult{Index: item.Index, ID: user.ID, Status: "created"}
+		}
+		return firstErr
+	})
+	if err != nil {
+		for i := range results {
+			if results[i].Status == "created" {
+				results[i].Status = "rolled_back"
+				results[i].ID = 0
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (s *userService) BulkDeleteUsers(ctx context.Context, ids []int, atomic bool) ([]dto.BulkResult, error) {
+	results := make([]dto.BulkResult, len(ids))
+
+	if !atomic {
+		for i, id := range ids {
+			if err := s.userRepo.Delete(ctx, id); err != nil {
+				results[i] = dto.BulkResult{Index: i, ID: id, Status: "failed", Error: err.Error()}
+				continue
+			}
+			results[i] = dto.BulkResult{Index: i, ID: id, Status: "deleted"}
+		}
+		return results, nil
+	}
+
+	err := s.txManager.WithinTx(ctx, func(txCtx context.Context) error {
+		var firstErr error
+		for i, id := range ids {
+			if err := s.userRepo.Delete(txCtx, id); err != nil {
+				results[i] = dto.BulkResult{Index: i, ID: id, Status: "failed", Error: err.Error()}
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			results[i] = dto.BulkResult{Index: i, ID: id, Status: "deleted"}
+		}
+		return firstErr
+	})
+	if err != nil {
+		for i := range results {
+			if results[i].Status == "deleted" {
+				results[i].Status = "rolled_back"
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func toUserResponse(user *models.User) dto.UserResponse {
+	return dto.UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Role:      user.Role,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/escuadron-404/red404/backend/internal/dto"
+	"github.com/escuadron-404/red404/backend/internal/models"
+	"github.com/escuadron-404/red404/backend/internal/pagination"
+	"github.com/escuadron-404/red404/backend/internal/repository"
+)
+
+// fakeUserRepo is an in-memory repository.UserRepository. Create fails for
+// any email in failEmails, so tests can force a mid-batch error.
+type fakeUserRepo struct {
+	byID       map[int]*models.User
+	failEmails map[string]bool
+}
+
+func newFakeUserRepo(failEmails ...string) *fakeUserRepo {
+	fail := map[string]bool{}
+	for _, e := range failEmails {
+		fail[e] = true
+	}
+	return &fakeUserRepo{byID: map[int]*models.User{}, failEmails: fail}
+}
+
+var errFakeCreate = errors.New("fake create failure")
+
+func (f *fakeUserRepo) Create(ctx context.Context, user *models.User) error {
+	if f.failEmails[user.Email] {
+		return errFakeCreate
+	}
+	user.ID = len(f.byID) + 1
+	f.byID[user.ID] = user
+	return nil
+}
+
+func (f *fakeUserRepo) GetByID(ctx context.Context, id int) (*models.User, error) {
+	user, ok := f.byID[id]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (f *fakeUserRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	return nil, repository.ErrUserNotFound
+}
+
+func (f *fakeUserRepo) List(ctx context.Context, limit, offset int) ([]*models.User, int, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeUserRepo) ListUsersKeyset(ctx context.Context, cursor pagination.Cursor, sortField, order string, limit int, filter repository.UserListFilter) ([]*models.User, int, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeUserRepo) Update(ctx context.Context, user *models.User) error {
+	f.byID[user.ID] = user
+	return nil
+}
+
+func (f *fakeUserRepo) Delete(ctx context.Context, id int) error {
+	if _, ok := f.byID[id]; !ok {
+		return repository.ErrUserNotFound
+	}
+	delete(f.byID, id)
+	return nil
+}
+
+// fakeTransactor runs fn directly against the given context; it doesn't
+// simulate an actual rollback since these tests only assert on the
+// per-item results the service reports, not on repository state.
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newItems(emails ...string) []BulkCreateItem {
+	items := make([]BulkCreateItem, len(emails))
+	for i, email := range emails {
+		items[i] = BulkCreateItem{
+			Index: i,
+			Request: dto.CreateUserRequest{
+				Email:    email,
+				Name:     "Test User",
+				Password: "hunter222",
+			},
+		}
+	}
+	return items
+}
+
+func TestBulkCreateUsersAtomicMarksCreatedItemsRolledBack(t *testing.T) {
+	repo := newFakeUserRepo("bad@example.com")
+	svc := NewUserService(repo, fakeTransactor{})
+
+	items := newItems("good1@example.com", "bad@example.com", "good2@example.com")
+	results, err := svc.BulkCreateUsers(context.Background(), items, true)
+	if err != nil {
+		t.Fatalf("BulkCreateUsers() error = %v, want nil", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("BulkCreateUsers() returned %d results, want %d", len(results), len(items))
+	}
+
+	want := []string{"rolled_back", "failed", "rolled_back"}
+	for i, r := range results {
+		if r.Index != i {
+			t.Errorf("results[%d].Index = %d, want %d", i, r.Index, i)
+		}
+		if r.Status != want[i] {
+			t.Errorf("results[%d].Status = %q, want %q", i, r.Status, want[i])
+		}
+		if r.ID != 0 {
+			t.Errorf("results[%d].ID = %d, want 0", i, r.ID)
+		}
+	}
+	if results[1].Error == "" {
+		t.Error("results[1].Error is empty, want the failure message")
+	}
+}
+
+func TestBulkCreateUsersNonAtomicPartialSuccess(t *testing.T) {
+	repo := newFakeUserRepo("bad@example.com")
+	svc := NewUserService(repo, fakeTransactor{})
+
+	items := newItems("good1@example.com", "bad@example.com", "good2@example.com")
+	results, err := svc.BulkCreateUsers(context.Background(), items, false)
+	if err != nil {
+		t.Fatalf("BulkCreateUsers() error = %v, want nil", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("BulkCreateUsers() returned %d results, want %d", len(results), len(items))
+	}
+
+	want := []string{"created", "failed", "created"}
+	for i, r := range results {
+		if r.Status != want[i] {
+			t.Errorf("results[%d].Status = %q, want %q", i, r.Status, want[i])
+		}
+	}
+	if results[1].Error == "" {
+		t.Error("results[1].Error is empty, want the failure message")
+	}
+	if results[0].ID == 0 || results[2].ID == 0 {
+		t.Error("successfully created items should have a non-zero ID")
+	}
+}
+
+func TestBulkDeleteUsersAtomicRollsBackAllOnFailure(t *testing.T) {
+	repo := newFakeUserRepo()
+	svc := NewUserService(repo, fakeTransactor{})
+	ctx := context.Background()
+
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		if _, err := svc.CreateUser(ctx, dto.CreateUserRequest{Email: email, Name: "Test User", Password: "hunter222"}); err != nil {
+			t.Fatalf("seeding user %q: %v", email, err)
+		}
+	}
+
+	results, err := svc.BulkDeleteUsers(ctx, []int{1, 999, 2}, true)
+	if err != nil {
+		t.Fatalf("BulkDeleteUsers() error = %v, want nil", err)
+	}
+
+	want := []string{"rolled_back", "failed", "rolled_back"}
+	for i, r := range results {
+		if r.Status != want[i] {
+			t.Errorf("results[%d].Status = %q, want %q", i, r.Status, want[i])
+		}
+	}
+}
+
+func TestBulkDeleteUsersNonAtomicPartialSuccess(t *testing.T) {
+	repo := newFakeUserRepo()
+	svc := NewUserService(repo, fakeTransactor{})
+	ctx := context.Background()
+
+	if _, err := svc.CreateUser(ctx, dto.CreateUserRequest{Email: "a@example.com", Name: "Test User", Password: "hunter222"}); err != nil {
+		t.Fatalf("seeding user: %v", err)
+	}
+
+	results, err := svc.BulkDeleteUsers(ctx, []int{1, 999}, false)
+	if err != nil {
+		t.Fatalf("BulkDeleteUsers() error = %v, want nil", err)
+	}
+
+	if results[0].Status != "deleted" {
+		t.Errorf("results[0].Status = %q, want %q", results[0].Status, "deleted")
+	}
+	if results[1].Status != "failed" {
+		t.Errorf("results[1].Status = %q, want %q", results[1].Status, "failed")
+	}
+}
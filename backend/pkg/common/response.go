@@ -0,0 +1,48 @@
+package common
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// Response is the envelope written by SuccessResponse, CreatedResponse and
+// ErrorResponse.
+type Response struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+	Error   interface{} `json:"error,omitempty"`
+}
+
+func JSONResponse(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		log.Printf("Error encoding response: %v", err)
+	}
+}
+
+func SuccessResponse(w http.ResponseWriter, data interface{}, message string) {
+	JSONResponse(w, http.StatusOK, Response{
+		Success: true,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func CreatedResponse(w http.ResponseWriter, data interface{}, message string) {
+	JSONResponse(w, http.StatusCreated, Response{
+		Success: true,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func ErrorResponse(w http.ResponseWriter, status int, message string, errors interface{}) {
+	JSONResponse(w, status, Response{
+		Success: false,
+		Message: message,
+		Error:   errors,
+	})
+}
@@ -0,0 +1,113 @@
+// Package problem implements RFC 7807 application/problem+json error
+// responses so every handler in the API returns a consistent error shape.
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const ContentType = "application/problem+json"
+
+// Detail is the RFC 7807 body. FieldErrors is a project-specific extension
+// carrying per-field validation failures.
+type Detail struct {
+	Type        string       `json:"type"`
+	Title       string       `json:"title"`
+	Status      int          `json:"status"`
+	Detail      string       `json:"detail,omitempty"`
+	Instance    string       `json:"instance,omitempty"`
+	FieldErrors []FieldError `json:"errors,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Code identifies a known application error and the RFC 7807 type/title/
+// status it maps to.
+type Code struct {
+	TypeURI string
+	Title   string
+	Status  int
+}
+
+// Registry of stable error codes. The TypeURI is a documentation reference,
+// not a URL that needs to resolve.
+var Registry = map[string]Code{
+	"users.not_found": {
+		TypeURI: "https://red404.dev/problems/users/not-found",
+		Title:   "User not found",
+		Status:  http.StatusNotFound,
+	},
+	"users.validation_failed": {
+		TypeURI: "https://red404.dev/problems/users/validation-failed",
+		Title:   "User validation failed",
+		Status:  http.StatusBadRequest,
+	},
+	"users.email_taken": {
+		TypeURI: "https://red404.dev/problems/users/email-taken",
+		Title:   "Email already in use",
+		Status:  http.StatusConflict,
+	},
+	"users.invalid_id": {
+		TypeURI: "https://red404.dev/problems/users/invalid-id",
+		Title:   "Invalid user ID",
+		Status:  http.StatusBadRequest,
+	},
+	"users.forbidden": {
+		TypeURI: "https://red404.dev/problems/users/forbidden",
+		Title:   "Not allowed to access this user",
+		Status:  http.StatusForbidden,
+	},
+	"common.internal_error": {
+		TypeURI: "https://red404.dev/problems/common/internal-error",
+		Title:   "Internal server error",
+		Status:  http.StatusInternalServerError,
+	},
+	"auth.invalid_credentials": {
+		TypeURI: "https://red404.dev/problems/auth/invalid-credentials",
+		Title:   "Invalid email or password",
+		Status:  http.StatusUnauthorized,
+	},
+	"auth.invalid_token": {
+		TypeURI: "https://red404.dev/problems/auth/invalid-token",
+		Title:   "Invalid or expired token",
+		Status:  http.StatusUnauthorized,
+	},
+	"auth.unauthorized": {
+		TypeURI: "https://red404.dev/problems/auth/unauthorized",
+		Title:   "Missing or malformed Authorization header",
+		Status:  http.StatusUnauthorized,
+	},
+}
+
+// New builds a Detail from a registered code, stamping it with the request
+// instance (usually the request ID).
+func New(code, detail, instance string) Detail {
+	c, ok := Registry[code]
+	if !ok {
+		c = Registry["common.internal_error"]
+	}
+	return Detail{
+		Type:     c.TypeURI,
+		Title:    c.Title,
+		Status:   c.Status,
+		Detail:   detail,
+		Instance: instance,
+	}
+}
+
+// WithFieldErrors attaches per-field validation errors to a Detail.
+func (d Detail) WithFieldErrors(errs []FieldError) Detail {
+	d.FieldErrors = errs
+	return d
+}
+
+// Write encodes the Detail as application/problem+json.
+func Write(w http.ResponseWriter, d Detail) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(d.Status)
+	_ = json.NewEncoder(w).Encode(d)
+}